@@ -0,0 +1,8 @@
+// Package common holds values that are shared across Edward's packages,
+// such as the tool's own version string.
+package common
+
+// EdwardVersion is the version of the edward binary currently running. It is
+// compared against the MinEdwardVersion field of loaded configs, and a config
+// asking for a newer version is logged; such a config is still loaded.
+var EdwardVersion = "0.0.0-dev"
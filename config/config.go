@@ -0,0 +1,71 @@
+// Package config handles loading, merging and writing Edward's JSON
+// config files.
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/yext/edward/services"
+)
+
+// Config is the in-memory representation of an Edward config file.
+type Config struct {
+	MinEdwardVersion string                                  `json:"minEdwardVersion,omitempty"`
+	Imports          []string                                `json:"imports,omitempty"`
+	ServiceMap       map[string]*services.ServiceConfig      `json:"services,omitempty"`
+	GroupMap         map[string]*services.ServiceGroupConfig `json:"groups,omitempty"`
+}
+
+// New returns an empty config ready to have services, groups or imports
+// added to it.
+func New() *Config {
+	return &Config{
+		ServiceMap: make(map[string]*services.ServiceConfig),
+		GroupMap:   make(map[string]*services.ServiceGroupConfig),
+	}
+}
+
+// LoadConfig reads and parses the config file at path. edwardVersion is the
+// version of the calling binary. If the config's MinEdwardVersion is greater
+// than edwardVersion (compared as plain strings), a message is written to
+// logger; the config is still loaded and returned, not rejected.
+func LoadConfig(path string, edwardVersion string, logger *log.Logger) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	cfg := New()
+	if err := json.NewDecoder(f).Decode(cfg); err != nil {
+		return nil, fmt.Errorf("could not parse config at %s: %v", path, err)
+	}
+	if cfg.ServiceMap == nil {
+		cfg.ServiceMap = make(map[string]*services.ServiceConfig)
+	}
+	if cfg.GroupMap == nil {
+		cfg.GroupMap = make(map[string]*services.ServiceGroupConfig)
+	}
+	if cfg.MinEdwardVersion != "" && cfg.MinEdwardVersion > edwardVersion {
+		if logger != nil {
+			logger.Printf("config at %s requests edward >= %s, running %s", path, cfg.MinEdwardVersion, edwardVersion)
+		}
+	}
+	return cfg, nil
+}
+
+// Write serializes the config as indented JSON to path.
+func (c *Config) Write(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "    ")
+	return enc.Encode(c)
+}
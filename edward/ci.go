@@ -0,0 +1,97 @@
+package edward
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/yext/edward/services"
+)
+
+// ciModeGithub selects GitHub Actions workflow-command output: ::group::,
+// ::notice::, ::warning:: and ::error:: lines instead of plain text, and a
+// Markdown job summary written to $GITHUB_STEP_SUMMARY.
+const ciModeGithub = "github"
+
+// ciMode returns the CI output mode currently in effect: c.CIMode if it's
+// set, otherwise "github" when Edward detects it's running inside a GitHub
+// Actions job, otherwise "".
+func (c *Client) ciMode() string {
+	if c.CIMode != "" {
+		return c.CIMode
+	}
+	if os.Getenv("GITHUB_ACTIONS") == "true" {
+		return ciModeGithub
+	}
+	return ""
+}
+
+// group wraps fn in a GitHub Actions log group named title when running
+// under that CI mode, and simply calls fn otherwise.
+func (c *Client) group(title string, fn func() error) error {
+	if c.ciMode() != ciModeGithub {
+		return fn()
+	}
+	fmt.Fprintf(c.Output, "::group::%s\n", title)
+	err := fn()
+	fmt.Fprintln(c.Output, "::endgroup::")
+	return err
+}
+
+// notice prints message as a plain info line, or a GitHub Actions
+// ::notice:: workflow command under that CI mode.
+func (c *Client) notice(message string) {
+	c.writeDiagnostic("notice", message, "", 0)
+}
+
+// writeDiagnostic prints a single generator diagnostic in the current CI
+// mode's native format, falling back to a plain "level: message
+// (file:line)" line when there is none.
+func (c *Client) writeDiagnostic(level, message, file string, line int) {
+	if c.ciMode() == ciModeGithub {
+		location := ""
+		switch {
+		case file != "" && line > 0:
+			location = fmt.Sprintf(" file=%s,line=%d", file, line)
+		case file != "":
+			location = fmt.Sprintf(" file=%s", file)
+		}
+		fmt.Fprintf(c.Output, "::%s%s::%s\n", level, location, message)
+		return
+	}
+
+	if file == "" {
+		fmt.Fprintf(c.Output, "%s: %s\n", level, message)
+		return
+	}
+	if line > 0 {
+		fmt.Fprintf(c.Output, "%s: %s (%s:%d)\n", level, message, file, line)
+		return
+	}
+	fmt.Fprintf(c.Output, "%s: %s (%s)\n", level, message, file)
+}
+
+// writeStepSummary appends a Markdown table of newServices to
+// $GITHUB_STEP_SUMMARY, if running under GitHub Actions and there's
+// anything to report.
+func (c *Client) writeStepSummary(newServices []*services.ServiceConfig) error {
+	if c.ciMode() != ciModeGithub || len(newServices) == 0 {
+		return nil
+	}
+	path := os.Getenv("GITHUB_STEP_SUMMARY")
+	if path == "" {
+		return nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fmt.Fprintln(f, "| Service | Build | Launch |")
+	fmt.Fprintln(f, "| --- | --- | --- |")
+	for _, s := range newServices {
+		fmt.Fprintf(f, "| %s | %s | %s |\n", s.Name, s.Commands.Build, s.Commands.Launch)
+	}
+	return nil
+}
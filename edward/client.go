@@ -0,0 +1,91 @@
+// Package edward implements the operations behind the edward command line
+// tool: generating configs, and building, starting and stopping services.
+package edward
+
+import (
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+)
+
+// Client drives Edward's operations. The zero value is not usable; use
+// NewClient to construct one.
+type Client struct {
+	// EdwardExecutable is the path to the edward binary to use when Edward
+	// needs to re-invoke itself, for example to run a service as a
+	// detached child process.
+	EdwardExecutable string
+
+	// DisableConcurrentPhases forces generate/build/start phases to run
+	// sequentially instead of in parallel. Tests use this to keep output
+	// deterministic.
+	DisableConcurrentPhases bool
+
+	// WorkingDir is the directory Generate and other commands operate
+	// relative to. If empty, the current working directory is used.
+	WorkingDir string
+
+	// Paths lists the root directories Generate should scan with its
+	// generators. If empty, only WorkingDir (or the current directory) is
+	// scanned. Multiple paths are scanned concurrently unless
+	// DisableConcurrentPhases is set, and their results are merged
+	// according to ConflictPolicy.
+	Paths []string
+
+	// ConflictPolicy decides how Generate resolves two paths proposing a
+	// service of the same name with different content. Its zero value is
+	// FailOnConflict.
+	ConflictPolicy ConflictPolicy
+
+	// EnablePlugins opts Generate in to scanning PATH for external
+	// "edward-generate-*" generator plugins. It defaults to false so that
+	// an unrelated executable on PATH can't be silently invoked by a
+	// generate call that isn't expecting plugins.
+	EnablePlugins bool
+
+	// Output and Input are used for all of Client's interactive prompts
+	// and human-readable output.
+	Output io.Writer
+	Input  io.Reader
+
+	// OutputFormat selects an alternate rendering for commands that
+	// support one. Currently only Generate honors it: set to "json" to
+	// have it emit its plan as JSON instead of prompting interactively.
+	OutputFormat string
+
+	// CIMode selects a CI-specific rendering for Client's output, such as
+	// "github" for GitHub Actions workflow commands. If empty, Client
+	// auto-detects GitHub Actions from its environment variables.
+	CIMode string
+
+	Logger *log.Logger
+}
+
+// NewClient returns a Client configured with sensible defaults: no logging,
+// and output directed to nowhere until the caller sets it.
+func NewClient() *Client {
+	return &Client{
+		Logger: log.New(ioutil.Discard, "", 0),
+		Output: ioutil.Discard,
+	}
+}
+
+func (c *Client) rootPath() string {
+	if c.WorkingDir != "" {
+		return c.WorkingDir
+	}
+	if wd, err := os.Getwd(); err == nil {
+		return wd
+	}
+	return "."
+}
+
+// rootPaths returns the directories Generate should scan: c.Paths if set,
+// otherwise just the single path rootPath returns.
+func (c *Client) rootPaths() []string {
+	if len(c.Paths) > 0 {
+		return c.Paths
+	}
+	return []string{c.rootPath()}
+}
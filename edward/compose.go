@@ -0,0 +1,81 @@
+package edward
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// composeGroupSuffix is the suffix generators.ComposeGenerator appends to
+// the owning service's name to build a group's Name. ExportCompose only
+// reinterprets groups matching this convention as depends_on; any other
+// group (hand-written, from a plugin generator, or merged from another
+// path) is left alone rather than silently misread.
+const composeGroupSuffix = "-group"
+
+type exportedCompose struct {
+	Services map[string]exportedComposeService `yaml:"services"`
+}
+
+type exportedComposeService struct {
+	Environment []string `yaml:"environment,omitempty"`
+	Ports       []string `yaml:"ports,omitempty"`
+	DependsOn   []string `yaml:"depends_on,omitempty"`
+}
+
+// ExportCompose writes a docker-compose.yml to root describing the current
+// config's services, the inverse of the docker-compose generator. Group
+// children are recorded back as depends_on entries so that a subsequent
+// "edward generate" round-trips to the same groups.
+func (c *Client) ExportCompose(root string) error {
+	cfg, configPath, err := c.loadOrCreateConfig(root)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(configPath); err != nil {
+		return fmt.Errorf("no config found at %s to export", configPath)
+	}
+
+	dependsOn := make(map[string][]string)
+	for _, group := range cfg.GroupMap {
+		if len(group.Children) == 0 || !strings.HasSuffix(group.Name, composeGroupSuffix) {
+			continue
+		}
+		last := group.Children[len(group.Children)-1]
+		if group.Name != last+composeGroupSuffix {
+			// Not a group ComposeGenerator would have produced for this
+			// owner; don't guess at what it means.
+			continue
+		}
+		dependsOn[last] = append(dependsOn[last], group.Children[:len(group.Children)-1]...)
+	}
+
+	out := exportedCompose{Services: make(map[string]exportedComposeService)}
+	for name, svc := range cfg.ServiceMap {
+		var ports []string
+		for _, port := range svc.LaunchChecks.Ports {
+			ports = append(ports, strconv.Itoa(port)+":"+strconv.Itoa(port))
+		}
+		out.Services[name] = exportedComposeService{
+			Environment: svc.Env,
+			Ports:       ports,
+			DependsOn:   dependsOn[name],
+		}
+	}
+
+	raw, err := yaml.Marshal(out)
+	if err != nil {
+		return err
+	}
+
+	composePath := filepath.Join(root, "docker-compose.yml")
+	if err := os.WriteFile(composePath, raw, 0644); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.Output, "Wrote to: %s\n", composePath)
+	return nil
+}
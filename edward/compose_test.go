@@ -0,0 +1,83 @@
+package edward_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yext/edward/config"
+	"github.com/yext/edward/edward"
+	"github.com/yext/edward/services"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func TestExportCompose(t *testing.T) {
+	dir := t.TempDir()
+
+	cfg := config.New()
+	cfg.ServiceMap["web"] = &services.ServiceConfig{
+		Name: "web",
+		Env:  []string{"FOO=bar"},
+		LaunchChecks: services.LaunchChecks{
+			Ports: []int{8080},
+		},
+	}
+	cfg.ServiceMap["db"] = &services.ServiceConfig{
+		Name: "db",
+	}
+	// A group following the convention ComposeGenerator itself creates:
+	// the last child is the owning service, the rest are its depends_on.
+	cfg.GroupMap["web-group"] = &services.ServiceGroupConfig{
+		Name:     "web-group",
+		Children: []string{"db", "web"},
+	}
+	// A hand-written group that happens to also end in "web" but wasn't
+	// produced by ComposeGenerator's convention: it must not be
+	// reinterpreted as a depends_on relationship.
+	cfg.GroupMap["everything"] = &services.ServiceGroupConfig{
+		Name:     "everything",
+		Children: []string{"db", "web"},
+	}
+
+	if err := cfg.Write(filepath.Join(dir, "edward.json")); err != nil {
+		t.Fatal(err)
+	}
+
+	client := edward.NewClient()
+	client.Output = os.Stdout
+	if err := client.ExportCompose(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(dir, "docker-compose.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out struct {
+		Services map[string]struct {
+			Environment []string `yaml:"environment"`
+			Ports       []string `yaml:"ports"`
+			DependsOn   []string `yaml:"depends_on"`
+		} `yaml:"services"`
+	}
+	if err := yaml.Unmarshal(raw, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	web, ok := out.Services["web"]
+	if !ok {
+		t.Fatal("expected a web service in the exported compose file")
+	}
+	if len(web.DependsOn) != 1 || web.DependsOn[0] != "db" {
+		t.Errorf("expected web to depend_on [db], got %v", web.DependsOn)
+	}
+
+	db, ok := out.Services["db"]
+	if !ok {
+		t.Fatal("expected a db service in the exported compose file")
+	}
+	if len(db.DependsOn) != 0 {
+		t.Errorf("expected db to have no depends_on from the non-convention group, got %v", db.DependsOn)
+	}
+}
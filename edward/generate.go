@@ -0,0 +1,184 @@
+package edward
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yext/edward/common"
+	"github.com/yext/edward/config"
+	"github.com/yext/edward/generators"
+	"github.com/yext/edward/services"
+)
+
+const configFileName = "edward.json"
+
+// Generate inspects the working directory with Edward's generators and adds
+// any new services, groups or imports it finds to the config. Unless force
+// is true, it first prompts on Input/Output for confirmation. names and
+// targets, if non-empty, restrict generation to the given services or
+// generator targets respectively. Anything a generator found that conflicts
+// with an existing entry of the same name is left unchanged and reported as
+// a warning diagnostic rather than silently applied or dropped.
+//
+// If c.OutputFormat is "json", Generate instead writes the plan as JSON to
+// Output and never prompts; force still governs whether the plan is
+// applied. See GeneratePlan/ApplyPlan for the non-interactive equivalent.
+func (c *Client) Generate(names []string, force bool, targets []string) error {
+	var plan *GeneratePlan
+	if err := c.group("Generate", func() error {
+		var err error
+		plan, err = c.GeneratePlan(names, targets)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	for _, d := range plan.Diagnostics {
+		c.writeDiagnostic(d.Level, d.Message, d.File, d.Line)
+	}
+
+	if c.OutputFormat == "json" {
+		return c.generateJSON(plan, force)
+	}
+
+	for _, conflict := range plan.Conflicts {
+		c.writeDiagnostic("warning", fmt.Sprintf("%s %q already exists with different content and was left unchanged", conflict.Kind, conflict.Name), "", 0)
+	}
+
+	if plan.IsEmpty() {
+		if c.ciMode() == ciModeGithub {
+			c.notice("No new services, groups or imports found")
+		} else {
+			fmt.Fprintln(c.Output, "No new services, groups or imports found")
+		}
+		return nil
+	}
+
+	if !force {
+		proceed, err := c.confirmGenerate(plan.NewServices, plan.NewGroups, plan.NewImports)
+		if err != nil {
+			return err
+		}
+		if !proceed {
+			return nil
+		}
+	}
+
+	if err := c.ApplyPlan(plan); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.Output, "Wrote to: %s\n", plan.configPath)
+	return c.writeStepSummary(plan.NewServices)
+}
+
+func (c *Client) generateJSON(plan *GeneratePlan, force bool) error {
+	if err := json.NewEncoder(c.Output).Encode(plan); err != nil {
+		return err
+	}
+	if force {
+		return c.ApplyPlan(plan)
+	}
+	return nil
+}
+
+func (c *Client) loadOrCreateConfig(root string) (*config.Config, string, error) {
+	path := filepath.Join(root, configFileName)
+	if _, err := os.Stat(path); err == nil {
+		cfg, err := config.LoadConfig(path, common.EdwardVersion, c.Logger)
+		return cfg, path, err
+	}
+	return config.New(), path, nil
+}
+
+// collect runs every registered generator over root and returns everything
+// they found, plus any diagnostics raised along the way. It does not filter
+// out services or groups that already exist in cfg: GeneratePlan needs to
+// see those too, to tell an exact match from a conflicting one. Imports have
+// no notion of "differing" from an existing entry, so those are filtered
+// here.
+func (c *Client) collect(root string, cfg *config.Config, targets []string) ([]*services.ServiceConfig, []*services.ServiceGroupConfig, []string, []generators.Diagnostic, error) {
+	var foundServices []*services.ServiceConfig
+	var foundGroups []*services.ServiceGroupConfig
+	var newImports []string
+	var diagnostics []generators.Diagnostic
+
+	var existingServices []string
+	for name := range cfg.ServiceMap {
+		existingServices = append(existingServices, name)
+	}
+
+	for _, generator := range generators.Generators(c.EnablePlugins) {
+		if len(targets) > 0 && !contains(targets, generator.Name()) {
+			continue
+		}
+
+		if ctxGenerator, ok := generator.(generators.GenerateContext); ok {
+			ctxGenerator.SetContext(existingServices, targets)
+		}
+
+		result, err := generator.Generate(root)
+		if err != nil {
+			return nil, nil, nil, nil, fmt.Errorf("%s: %v", generator.Name(), err)
+		}
+		if result == nil {
+			continue
+		}
+
+		foundServices = append(foundServices, result.Services...)
+		foundGroups = append(foundGroups, result.Groups...)
+		for _, imp := range result.Imports {
+			if !containsImport(cfg.Imports, imp) {
+				newImports = append(newImports, imp)
+			}
+		}
+		diagnostics = append(diagnostics, result.Diagnostics...)
+	}
+	return foundServices, foundGroups, newImports, diagnostics, nil
+}
+
+func (c *Client) confirmGenerate(newServices []*services.ServiceConfig, newGroups []*services.ServiceGroupConfig, newImports []string) (bool, error) {
+	fmt.Fprintln(c.Output, "The following will be generated:")
+	if len(newServices) > 0 {
+		fmt.Fprintln(c.Output, "Services:")
+		for _, s := range newServices {
+			fmt.Fprintf(c.Output, "\t%s\n", s.Name)
+		}
+	}
+	if len(newGroups) > 0 {
+		fmt.Fprintln(c.Output, "Groups:")
+		for _, g := range newGroups {
+			fmt.Fprintf(c.Output, "\t%s\n", g.Name)
+		}
+	}
+	if len(newImports) > 0 {
+		fmt.Fprintln(c.Output, "Imports:")
+		for _, i := range newImports {
+			fmt.Fprintf(c.Output, "\t%s\n", i)
+		}
+	}
+	fmt.Fprint(c.Output, "Do you wish to continue? [y/n]? ")
+
+	reader := bufio.NewReader(c.Input)
+	response, err := reader.ReadString('\n')
+	if err != nil {
+		return false, err
+	}
+	return strings.EqualFold(strings.TrimSpace(response), "y"), nil
+}
+
+func contains(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+	return false
+}
+
+func containsImport(imports []string, imp string) bool {
+	return contains(imports, imp)
+}
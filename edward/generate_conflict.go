@@ -0,0 +1,60 @@
+package edward
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yext/edward/services"
+)
+
+// ConflictPolicy decides how Generate resolves two scanned paths proposing
+// a service of the same name with different content (any field, such as
+// Path or Commands, that differs).
+type ConflictPolicy int
+
+const (
+	// FailOnConflict returns a *GenerateConflictError, listing every
+	// conflicting candidate, instead of picking one.
+	FailOnConflict ConflictPolicy = iota
+	// PreferFirst keeps the candidate from whichever path came first in
+	// Client.Paths.
+	PreferFirst
+	// PreferLongestPath keeps the candidate whose Path is the longest
+	// string, on the theory that a more deeply nested service definition
+	// is the more specific one.
+	PreferLongestPath
+)
+
+// GenerateConflictCandidate is one of the conflicting proposals for a
+// service name in a GenerateConflictError.
+type GenerateConflictCandidate struct {
+	Path    string
+	Service *services.ServiceConfig
+}
+
+// GenerateServiceConflict is every conflicting proposal found for a single
+// service name.
+type GenerateServiceConflict struct {
+	Name       string
+	Candidates []GenerateConflictCandidate
+}
+
+// GenerateConflictError is returned by Generate/GeneratePlan when two of
+// Client.Paths produce a service with the same name but differing content
+// (such as Path or Commands), and ConflictPolicy is FailOnConflict. It reports every
+// conflicting name found in a single pass, not just the first.
+type GenerateConflictError struct {
+	Conflicts []GenerateServiceConflict
+}
+
+func (e *GenerateConflictError) Error() string {
+	var descriptions []string
+	for _, conflict := range e.Conflicts {
+		var paths []string
+		for _, c := range conflict.Candidates {
+			paths = append(paths, c.Path)
+		}
+		descriptions = append(descriptions, fmt.Sprintf("%q found in: %s", conflict.Name, strings.Join(paths, ", ")))
+	}
+	return fmt.Sprintf("conflicting definitions for services %s", strings.Join(descriptions, "; "))
+}
@@ -0,0 +1,167 @@
+package edward
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/yext/edward/config"
+	"github.com/yext/edward/generators"
+	"github.com/yext/edward/services"
+)
+
+// pathResult is what collect found when scanning a single root path.
+type pathResult struct {
+	path        string
+	services    []*services.ServiceConfig
+	groups      []*services.ServiceGroupConfig
+	imports     []string
+	diagnostics []generators.Diagnostic
+	err         error
+}
+
+// collectPaths runs collect over every path, concurrently unless
+// DisableConcurrentPhases is set, and merges the results. Services that two
+// paths propose under the same name are deduped if identical, resolved per
+// c.ConflictPolicy if not, and otherwise returned as a
+// *GenerateConflictError.
+func (c *Client) collectPaths(paths []string, cfg *config.Config, targets []string) ([]*services.ServiceConfig, []*services.ServiceGroupConfig, []string, []generators.Diagnostic, error) {
+	results := make([]pathResult, len(paths))
+
+	collectOne := func(i int) {
+		svcs, groups, imports, diags, err := c.collect(paths[i], cfg, targets)
+		results[i] = pathResult{path: paths[i], services: svcs, groups: groups, imports: imports, diagnostics: diags, err: err}
+	}
+
+	if c.DisableConcurrentPhases || len(paths) == 1 {
+		for i := range paths {
+			collectOne(i)
+		}
+	} else {
+		var wg sync.WaitGroup
+		wg.Add(len(paths))
+		for i := range paths {
+			i := i
+			go func() {
+				defer wg.Done()
+				collectOne(i)
+			}()
+		}
+		wg.Wait()
+	}
+
+	for _, r := range results {
+		if r.err != nil {
+			return nil, nil, nil, nil, r.err
+		}
+	}
+
+	mergedServices, err := c.mergeServices(results)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	var groups []*services.ServiceGroupConfig
+	var imports []string
+	var diagnostics []generators.Diagnostic
+	seenGroups := map[string]bool{}
+	seenImports := map[string]bool{}
+	for _, r := range results {
+		for _, group := range r.groups {
+			if !seenGroups[group.Name] {
+				seenGroups[group.Name] = true
+				groups = append(groups, group)
+			}
+		}
+		for _, imp := range r.imports {
+			if !seenImports[imp] {
+				seenImports[imp] = true
+				imports = append(imports, imp)
+			}
+		}
+		diagnostics = append(diagnostics, r.diagnostics...)
+	}
+
+	return mergedServices, groups, imports, diagnostics, nil
+}
+
+// serviceCandidate is one path's proposal for a service name, tracked so
+// conflicts can report which paths disagreed.
+type serviceCandidate struct {
+	path string
+	svc  *services.ServiceConfig
+}
+
+// mergeServices combines the services found across results, applying
+// c.ConflictPolicy to any name proposed more than once with differing
+// content. Names are processed in sorted order so that merging (and any
+// resulting GenerateConflictError) is independent of the order results were
+// collected in, which itself depends on generator output order.
+func (c *Client) mergeServices(results []pathResult) ([]*services.ServiceConfig, error) {
+	byName := make(map[string][]serviceCandidate)
+	for _, r := range results {
+		for _, svc := range r.services {
+			byName[svc.Name] = append(byName[svc.Name], serviceCandidate{path: r.path, svc: svc})
+		}
+	}
+
+	var names []string
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var merged []*services.ServiceConfig
+	var conflicts []GenerateServiceConflict
+	for _, name := range names {
+		candidates := byName[name]
+		if len(candidates) == 1 || allIdentical(candidates) {
+			merged = append(merged, candidates[0].svc)
+			continue
+		}
+
+		switch c.ConflictPolicy {
+		case PreferFirst:
+			merged = append(merged, candidates[0].svc)
+		case PreferLongestPath:
+			merged = append(merged, longestPathCandidate(candidates).svc)
+		default:
+			var conflictCandidates []GenerateConflictCandidate
+			for _, cand := range candidates {
+				conflictCandidates = append(conflictCandidates, GenerateConflictCandidate{Path: cand.path, Service: cand.svc})
+			}
+			conflicts = append(conflicts, GenerateServiceConflict{Name: name, Candidates: conflictCandidates})
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return nil, &GenerateConflictError{Conflicts: conflicts}
+	}
+	return merged, nil
+}
+
+func allIdentical(candidates []serviceCandidate) bool {
+	for _, cand := range candidates[1:] {
+		if !reflect.DeepEqual(cand.svc, candidates[0].svc) {
+			return false
+		}
+	}
+	return true
+}
+
+func longestPathCandidate(candidates []serviceCandidate) serviceCandidate {
+	best := candidates[0]
+	for _, cand := range candidates[1:] {
+		if servicePathLen(cand.svc) > servicePathLen(best.svc) {
+			best = cand
+		}
+	}
+	return best
+}
+
+func servicePathLen(svc *services.ServiceConfig) int {
+	if svc.Path == nil {
+		return 0
+	}
+	return len(*svc.Path)
+}
@@ -0,0 +1,131 @@
+package edward
+
+import (
+	"testing"
+
+	"github.com/yext/edward/services"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestMergeServices(t *testing.T) {
+	var tests = []struct {
+		name              string
+		policy            ConflictPolicy
+		results           []pathResult
+		expectedNames     []string
+		expectedPath      string
+		expectConflict    bool
+		expectedConflicts []string
+	}{
+		{
+			name: "no conflict",
+			results: []pathResult{
+				{path: "a", services: []*services.ServiceConfig{{Name: "svc-a"}}},
+				{path: "b", services: []*services.ServiceConfig{{Name: "svc-b"}}},
+			},
+			expectedNames: []string{"svc-a", "svc-b"},
+		},
+		{
+			name: "identical duplicates are silently deduped",
+			results: []pathResult{
+				{path: "a", services: []*services.ServiceConfig{{Name: "svc", Path: strPtr("a")}}},
+				{path: "b", services: []*services.ServiceConfig{{Name: "svc", Path: strPtr("a")}}},
+			},
+			expectedNames: []string{"svc"},
+		},
+		{
+			name: "path-prefix conflict fails by default",
+			results: []pathResult{
+				{path: "a", services: []*services.ServiceConfig{{Name: "svc", Path: strPtr("a")}}},
+				{path: "a/sub", services: []*services.ServiceConfig{{Name: "svc", Path: strPtr("a/sub")}}},
+			},
+			expectConflict:    true,
+			expectedConflicts: []string{"svc"},
+		},
+		{
+			name: "multiple simultaneous conflicts are all reported",
+			results: []pathResult{
+				{path: "a", services: []*services.ServiceConfig{
+					{Name: "svc-x", Path: strPtr("a")},
+					{Name: "svc-y", Path: strPtr("a")},
+				}},
+				{path: "b", services: []*services.ServiceConfig{
+					{Name: "svc-x", Path: strPtr("b")},
+					{Name: "svc-y", Path: strPtr("b")},
+				}},
+			},
+			expectConflict:    true,
+			expectedConflicts: []string{"svc-x", "svc-y"},
+		},
+		{
+			name:   "path-prefix conflict resolved by PreferLongestPath",
+			policy: PreferLongestPath,
+			results: []pathResult{
+				{path: "a", services: []*services.ServiceConfig{{Name: "svc", Path: strPtr("a")}}},
+				{path: "a/sub", services: []*services.ServiceConfig{{Name: "svc", Path: strPtr("a/sub")}}},
+			},
+			expectedNames: []string{"svc"},
+			expectedPath:  "a/sub",
+		},
+		{
+			name:   "path-prefix conflict resolved by PreferFirst",
+			policy: PreferFirst,
+			results: []pathResult{
+				{path: "a", services: []*services.ServiceConfig{{Name: "svc", Path: strPtr("a")}}},
+				{path: "a/sub", services: []*services.ServiceConfig{{Name: "svc", Path: strPtr("a/sub")}}},
+			},
+			expectedNames: []string{"svc"},
+			expectedPath:  "a",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &Client{ConflictPolicy: test.policy}
+			merged, err := c.mergeServices(test.results)
+
+			if test.expectConflict {
+				if err == nil {
+					t.Fatal("expected a conflict error")
+				}
+				conflictErr, ok := err.(*GenerateConflictError)
+				if !ok {
+					t.Fatalf("expected *GenerateConflictError, got %T", err)
+				}
+				var names []string
+				for _, conflict := range conflictErr.Conflicts {
+					names = append(names, conflict.Name)
+				}
+				if len(names) != len(test.expectedConflicts) {
+					t.Fatalf("expected conflicts %v, got %v", test.expectedConflicts, names)
+				}
+				for i, name := range test.expectedConflicts {
+					if names[i] != name {
+						t.Fatalf("expected conflicts %v, got %v", test.expectedConflicts, names)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var names []string
+			for _, s := range merged {
+				names = append(names, s.Name)
+			}
+			if len(names) != len(test.expectedNames) {
+				t.Fatalf("expected services %v, got %v", test.expectedNames, names)
+			}
+			for i, name := range test.expectedNames {
+				if names[i] != name {
+					t.Fatalf("expected services %v, got %v", test.expectedNames, names)
+				}
+			}
+			if test.expectedPath != "" && *merged[0].Path != test.expectedPath {
+				t.Errorf("expected path %q, got %q", test.expectedPath, *merged[0].Path)
+			}
+		})
+	}
+}
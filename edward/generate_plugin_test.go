@@ -0,0 +1,91 @@
+package edward_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/yext/edward/edward"
+)
+
+// buildFixturePlugin compiles the fixture generator plugin from the
+// generators package's testdata and returns the directory containing the
+// resulting edward-generate-fixture binary. See generators.TestDiscoverPlugins
+// for the lower-level protocol test this fixture also serves.
+func buildFixturePlugin(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	name := "edward-generate-fixture"
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	out := filepath.Join(dir, name)
+
+	cmd := exec.Command("go", "build", "-o", out, "../generators/testdata/plugins/fixture")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("could not build fixture plugin: %v\n%s", err, output)
+	}
+	return dir
+}
+
+// TestGeneratePlanWithPlugin exercises GeneratePlan (and so collect and
+// generators.Generators) end-to-end with EnablePlugins set, confirming that
+// a discovered plugin's services are actually picked up and merged
+// alongside the built-in generators, not just returned by DiscoverPlugins
+// in isolation.
+func TestGeneratePlanWithPlugin(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	pluginDir := buildFixturePlugin(t)
+	t.Setenv("PATH", pluginDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	client := edward.NewClient()
+	client.WorkingDir = t.TempDir()
+	client.EnablePlugins = true
+
+	plan, err := client.GeneratePlan(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var found bool
+	for _, svc := range plan.NewServices {
+		if svc.Name == "fixture-service" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected fixture-service from the plugin in the plan, got %+v", plan.NewServices)
+	}
+}
+
+// TestGeneratePlanDoesNotDiscoverPluginsByDefault confirms that a
+// GeneratePlan call which doesn't opt in to EnablePlugins never invokes a
+// plugin on PATH, even when one is discoverable there.
+func TestGeneratePlanDoesNotDiscoverPluginsByDefault(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	pluginDir := buildFixturePlugin(t)
+	t.Setenv("PATH", pluginDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	client := edward.NewClient()
+	client.WorkingDir = t.TempDir()
+
+	plan, err := client.GeneratePlan(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, svc := range plan.NewServices {
+		if svc.Name == "fixture-service" {
+			t.Error("expected fixture-service not to appear without EnablePlugins")
+		}
+	}
+}
@@ -28,6 +28,8 @@ func TestGenerate(t *testing.T) {
 		services         []string
 		targets          []string
 		force            bool
+		outputFormat     string
+		ciMode           string
 		input            string
 		expectedOutput   string
 		expectedServices []string
@@ -36,39 +38,90 @@ func TestGenerate(t *testing.T) {
 	}{
 		{
 			name:             "existing config and services",
-			path:             "testdata/generate/singlewithconfig",
+			path:             "testdata/generate/compose-existing",
 			config:           "edward.json",
 			expectedOutput:   "No new services, groups or imports found\n",
-			expectedServices: []string{"edward-test-service"},
+			expectedServices: []string{"web"},
 		},
 		{
 			name:             "existing config and services - forced",
-			path:             "testdata/generate/singlewithconfig",
+			path:             "testdata/generate/compose-existing",
 			config:           "edward.json",
 			expectedOutput:   "No new services, groups or imports found\n",
 			force:            true,
-			expectedServices: []string{"edward-test-service"},
+			expectedServices: []string{"web"},
 		},
 		{
 			name:   "new config and service",
-			path:   "testdata/generate/single",
+			path:   "testdata/generate/compose",
 			config: "edward.json",
 			input:  "Y\n",
 			expectedOutput: `The following will be generated:
 Services:
-	edward-test-service
+	web
 Do you wish to continue? [y/n]? Wrote to: ${TMP_PATH}/edward.json
 `,
-			expectedServices: []string{"edward-test-service"},
+			expectedServices: []string{"web"},
 		},
 		{
 			name:   "new config and service - forced",
-			path:   "testdata/generate/single",
+			path:   "testdata/generate/compose",
 			config: "edward.json",
 			force:  true,
 			expectedOutput: `Wrote to: ${TMP_PATH}/edward.json
 `,
-			expectedServices: []string{"edward-test-service"},
+			expectedServices: []string{"web"},
+		},
+		{
+			name:             "json plan - no changes",
+			path:             "testdata/generate/compose-existing",
+			config:           "edward.json",
+			outputFormat:     "json",
+			expectedOutput:   "{}\n",
+			expectedServices: []string{"web"},
+		},
+		{
+			name:         "json plan - new service, not applied",
+			path:         "testdata/generate/compose",
+			config:       "edward.json",
+			outputFormat: "json",
+			expectedOutput: `{"newServices":[{"name":"web","commands":{"build":"docker compose build web","launch":"docker compose up web"},"launch_checks":{"ports":[8080]}}]}
+`,
+		},
+		{
+			name:         "json plan - new service, applied",
+			path:         "testdata/generate/compose",
+			config:       "edward.json",
+			outputFormat: "json",
+			force:        true,
+			expectedOutput: `{"newServices":[{"name":"web","commands":{"build":"docker compose build web","launch":"docker compose up web"},"launch_checks":{"ports":[8080]}}]}
+`,
+			expectedServices: []string{"web"},
+		},
+		{
+			name:             "github actions - no changes",
+			path:             "testdata/generate/compose-existing",
+			config:           "edward.json",
+			ciMode:           "github",
+			expectedOutput:   "::group::Generate\n::endgroup::\n::notice::No new services, groups or imports found\n",
+			expectedServices: []string{"web"},
+		},
+		{
+			name:             "existing service changed - conflict reported, left unchanged",
+			path:             "testdata/generate/compose-conflict",
+			config:           "edward.json",
+			force:            true,
+			expectedOutput:   "warning: service \"web\" already exists with different content and was left unchanged\nWrote to: ${TMP_PATH}/edward.json\n",
+			expectedServices: []string{"web"},
+		},
+		{
+			name:         "json plan - existing service changed",
+			path:         "testdata/generate/compose-conflict",
+			config:       "edward.json",
+			outputFormat: "json",
+			expectedOutput: `{"conflicts":[{"kind":"service","name":"web"}]}
+`,
+			expectedServices: []string{"web"},
 		},
 	}
 	for _, test := range tests {
@@ -87,6 +140,8 @@ Do you wish to continue? [y/n]? Wrote to: ${TMP_PATH}/edward.json
 			client := edward.NewClient()
 			client.EdwardExecutable = edwardExecutable
 			client.DisableConcurrentPhases = true
+			client.OutputFormat = test.outputFormat
+			client.CIMode = test.ciMode
 
 			// Set up input and output for the client
 			var outputReader, inputReader *io.PipeReader
@@ -107,11 +162,10 @@ Do you wish to continue? [y/n]? Wrote to: ${TMP_PATH}/edward.json
 			}()
 
 			var output string
+			var readErr error
 			go func() {
 				outBytes, err := ioutil.ReadAll(outputReader)
-				if err != nil {
-					t.Fatal(err)
-				}
+				readErr = err
 				output = string(outBytes)
 				ioWg.Done()
 			}()
@@ -122,6 +176,9 @@ Do you wish to continue? [y/n]? Wrote to: ${TMP_PATH}/edward.json
 			outputWriter.Close()
 
 			ioWg.Wait()
+			if readErr != nil {
+				t.Fatal(readErr)
+			}
 
 			cwd, err := os.Getwd()
 			if err != nil {
@@ -149,4 +206,4 @@ Do you wish to continue? [y/n]? Wrote to: ${TMP_PATH}/edward.json
 			}
 		})
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,76 @@
+package edward_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// edwardExecutable is the path to an edward binary for tests that need to
+// shell out to it. None of the generate tests currently do, so it's left
+// empty.
+var edwardExecutable string
+
+// createWorkingDir copies the fixture at path into a fresh temp directory,
+// changes the working directory there for the duration of the test, and
+// returns a func that restores the original working directory.
+func createWorkingDir(t *testing.T, name string, path string) func() {
+	t.Helper()
+
+	original, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	if err := copyDir(path, dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	return func() {
+		if err := os.Chdir(original); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func copyDir(src, dst string) error {
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return nil
+	}
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		return copyFile(path, target, info.Mode())
+	})
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
@@ -0,0 +1,123 @@
+package edward
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/yext/edward/config"
+	"github.com/yext/edward/generators"
+	"github.com/yext/edward/services"
+)
+
+// GeneratePlan is the structured result of scanning a project with Edward's
+// generators: everything that would be added to the config by ApplyPlan,
+// plus anything that conflicts with what's already there. It is the
+// non-interactive counterpart to the prompt Generate shows by default.
+type GeneratePlan struct {
+	NewServices []*services.ServiceConfig      `json:"newServices,omitempty"`
+	NewGroups   []*services.ServiceGroupConfig `json:"newGroups,omitempty"`
+	NewImports  []string                       `json:"newImports,omitempty"`
+	Conflicts   []PlanConflict                 `json:"conflicts,omitempty"`
+	Diagnostics []generators.Diagnostic        `json:"diagnostics,omitempty"`
+
+	// configPath and cfg let ApplyPlan write the plan back without
+	// reloading and re-diffing the config it came from.
+	configPath string
+	cfg        *config.Config
+}
+
+// PlanConflict describes a service or group a generator found that already
+// exists in the config under the same name, but with different content.
+type PlanConflict struct {
+	Kind string `json:"kind"` // "service" or "group"
+	Name string `json:"name"`
+}
+
+// GeneratePlan inspects c.Paths (or the working directory, if unset) with
+// Edward's generators and returns a structured diff against the current
+// config, without prompting or writing anything. names and targets restrict
+// generation as they do for Generate. If c.Paths has more than one entry,
+// each is scanned concurrently unless DisableConcurrentPhases is set, and
+// conflicting results are resolved per c.ConflictPolicy.
+func (c *Client) GeneratePlan(names []string, targets []string) (*GeneratePlan, error) {
+	cfg, configPath, err := c.loadOrCreateConfig(c.rootPath())
+	if err != nil {
+		return nil, err
+	}
+
+	foundServices, foundGroups, newImports, diagnostics, err := c.collectPaths(c.rootPaths(), cfg, targets)
+	if err != nil {
+		return nil, err
+	}
+
+	// foundServices/foundGroups include entries that already exist in cfg
+	// under the same name: compare those for equality before discarding
+	// them, rather than discarding them unconditionally, so a generator
+	// finding a changed definition for an existing name is reported as a
+	// conflict instead of silently ignored.
+	var conflicts []PlanConflict
+	var filteredServices []*services.ServiceConfig
+	for _, svc := range foundServices {
+		if len(names) > 0 && !contains(names, svc.Name) {
+			continue
+		}
+		if existing, ok := cfg.ServiceMap[svc.Name]; ok {
+			if !reflect.DeepEqual(existing, svc) {
+				conflicts = append(conflicts, PlanConflict{Kind: "service", Name: svc.Name})
+			}
+			continue
+		}
+		filteredServices = append(filteredServices, svc)
+	}
+	var filteredGroups []*services.ServiceGroupConfig
+	for _, group := range foundGroups {
+		if existing, ok := cfg.GroupMap[group.Name]; ok {
+			if !reflect.DeepEqual(existing, group) {
+				conflicts = append(conflicts, PlanConflict{Kind: "group", Name: group.Name})
+			}
+			continue
+		}
+		filteredGroups = append(filteredGroups, group)
+	}
+
+	sort.Slice(filteredServices, func(i, j int) bool { return filteredServices[i].Name < filteredServices[j].Name })
+	sort.Slice(filteredGroups, func(i, j int) bool { return filteredGroups[i].Name < filteredGroups[j].Name })
+	sort.Strings(newImports)
+	sort.Slice(conflicts, func(i, j int) bool {
+		if conflicts[i].Kind != conflicts[j].Kind {
+			return conflicts[i].Kind < conflicts[j].Kind
+		}
+		return conflicts[i].Name < conflicts[j].Name
+	})
+
+	return &GeneratePlan{
+		NewServices: filteredServices,
+		NewGroups:   filteredGroups,
+		NewImports:  newImports,
+		Conflicts:   conflicts,
+		Diagnostics: diagnostics,
+		configPath:  configPath,
+		cfg:         cfg,
+	}, nil
+}
+
+// IsEmpty reports whether the plan has nothing to apply and no conflicts.
+func (p *GeneratePlan) IsEmpty() bool {
+	return len(p.NewServices) == 0 && len(p.NewGroups) == 0 && len(p.NewImports) == 0 && len(p.Conflicts) == 0
+}
+
+// ApplyPlan writes the new services, groups and imports in plan to the
+// config it was generated from. It does not re-check for conflicts; a
+// caller that cares about plan.Conflicts should have already decided how
+// to handle them.
+func (c *Client) ApplyPlan(plan *GeneratePlan) error {
+	cfg := plan.cfg
+	for _, service := range plan.NewServices {
+		cfg.ServiceMap[service.Name] = service
+	}
+	for _, group := range plan.NewGroups {
+		cfg.GroupMap[group.Name] = group
+	}
+	cfg.Imports = append(cfg.Imports, plan.NewImports...)
+	return cfg.Write(plan.configPath)
+}
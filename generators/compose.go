@@ -0,0 +1,139 @@
+package generators
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yext/edward/services"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func init() {
+	Register(&ComposeGenerator{})
+}
+
+// ComposeGenerator builds Edward services and groups from an existing
+// docker-compose.yml, so that multi-container projects can be brought
+// under Edward without hand-writing a config.
+type ComposeGenerator struct{}
+
+// Name identifies this generator in diagnostics and conflict errors.
+func (g *ComposeGenerator) Name() string {
+	return "docker-compose"
+}
+
+type composeFile struct {
+	Services map[string]composeService `yaml:"services"`
+}
+
+type composeService struct {
+	Image       string      `yaml:"image"`
+	Environment interface{} `yaml:"environment"`
+	Ports       []string    `yaml:"ports"`
+	DependsOn   []string    `yaml:"depends_on"`
+}
+
+// Generate looks for a docker-compose.yml in path and, if found, returns a
+// service per compose service plus a group reflecting each service's
+// depends_on relationships.
+func (g *ComposeGenerator) Generate(path string) (*Result, error) {
+	composePath := filepath.Join(path, "docker-compose.yml")
+	raw, err := os.ReadFile(composePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &Result{}, nil
+		}
+		return nil, err
+	}
+
+	var parsed composeFile
+	if err := yaml.Unmarshal(raw, &parsed); err != nil {
+		return nil, fmt.Errorf("could not parse %s: %v", composePath, err)
+	}
+
+	result := &Result{}
+	for name, svc := range parsed.Services {
+		svc := svc
+		ports, err := launchPorts(svc.Ports)
+		if err != nil {
+			return nil, fmt.Errorf("service %s: %v", name, err)
+		}
+
+		result.Services = append(result.Services, &services.ServiceConfig{
+			Name: name,
+			Env:  composeEnv(svc.Environment),
+			Commands: services.Commands{
+				Build:  "docker compose build " + name,
+				Launch: "docker compose up " + name,
+			},
+			LaunchChecks: services.LaunchChecks{
+				Ports: ports,
+			},
+		})
+
+		if len(svc.DependsOn) > 0 {
+			result.Groups = append(result.Groups, &services.ServiceGroupConfig{
+				Name:     name + "-group",
+				Children: append(append([]string{}, svc.DependsOn...), name),
+			})
+		}
+	}
+
+	// parsed.Services is a map, so iteration order above is randomized per
+	// run. Sort before returning so callers (and their diagnostics, such as
+	// conflict errors) see a deterministic order without having to re-sort
+	// themselves.
+	sort.Slice(result.Services, func(i, j int) bool {
+		return result.Services[i].Name < result.Services[j].Name
+	})
+	sort.Slice(result.Groups, func(i, j int) bool {
+		return result.Groups[i].Name < result.Groups[j].Name
+	})
+
+	return result, nil
+}
+
+// composeEnv normalizes the compose "environment" key, which may be either
+// a list of "KEY=VALUE" strings or a map of key to value, into the list
+// form Edward uses.
+func composeEnv(raw interface{}) []string {
+	switch v := raw.(type) {
+	case []interface{}:
+		var out []string
+		for _, entry := range v {
+			out = append(out, fmt.Sprintf("%v", entry))
+		}
+		return out
+	case map[interface{}]interface{}:
+		var out []string
+		for key, value := range v {
+			out = append(out, fmt.Sprintf("%v=%v", key, value))
+		}
+		sort.Strings(out)
+		return out
+	default:
+		return nil
+	}
+}
+
+// launchPorts extracts the host-side ports from a compose "ports" list,
+// where each entry is of the form "HOST:CONTAINER" or just "PORT".
+func launchPorts(raw []string) ([]int, error) {
+	var ports []int
+	for _, entry := range raw {
+		hostPart := entry
+		if idx := strings.Index(entry, ":"); idx >= 0 {
+			hostPart = entry[:idx]
+		}
+		port, err := strconv.Atoi(hostPart)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q: %v", entry, err)
+		}
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
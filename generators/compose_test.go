@@ -0,0 +1,102 @@
+package generators
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+func TestComposeGenerate(t *testing.T) {
+	var tests = []struct {
+		name             string
+		compose          string
+		expectedServices []string
+		expectedGroups   []string
+		expectedPorts    map[string][]int
+	}{
+		{
+			name:    "no compose file",
+			compose: "",
+		},
+		{
+			name: "single service",
+			compose: `
+services:
+  web:
+    image: myapp
+    ports:
+      - "8080:80"
+`,
+			expectedServices: []string{"web"},
+			expectedPorts:    map[string][]int{"web": {8080}},
+		},
+		{
+			name: "service with dependency",
+			compose: `
+services:
+  web:
+    image: myapp
+    ports:
+      - "8080:80"
+    depends_on:
+      - db
+  db:
+    image: postgres
+    ports:
+      - "5432:5432"
+`,
+			expectedServices: []string{"db", "web"},
+			expectedGroups:   []string{"web-group"},
+			expectedPorts:    map[string][]int{"web": {8080}, "db": {5432}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if test.compose != "" {
+				if err := os.WriteFile(filepath.Join(dir, "docker-compose.yml"), []byte(test.compose), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			result, err := (&ComposeGenerator{}).Generate(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var services []string
+			ports := make(map[string][]int)
+			for _, svc := range result.Services {
+				services = append(services, svc.Name)
+				ports[svc.Name] = svc.LaunchChecks.Ports
+			}
+			sort.Strings(services)
+
+			var groups []string
+			for _, g := range result.Groups {
+				groups = append(groups, g.Name)
+			}
+			sort.Strings(groups)
+
+			if len(services) != len(test.expectedServices) {
+				t.Fatalf("expected services %v, got %v", test.expectedServices, services)
+			}
+			for i, name := range test.expectedServices {
+				if services[i] != name {
+					t.Errorf("expected services %v, got %v", test.expectedServices, services)
+				}
+			}
+			for name, want := range test.expectedPorts {
+				got := ports[name]
+				if len(got) != len(want) || (len(got) > 0 && got[0] != want[0]) {
+					t.Errorf("service %s: expected ports %v, got %v", name, want, got)
+				}
+			}
+			if len(groups) != len(test.expectedGroups) {
+				t.Fatalf("expected groups %v, got %v", test.expectedGroups, groups)
+			}
+		})
+	}
+}
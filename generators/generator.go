@@ -0,0 +1,59 @@
+// Package generators implements Edward's "generate" support: inspecting a
+// project directory and proposing services, groups and imports that would
+// represent it as an Edward config.
+package generators
+
+import "github.com/yext/edward/services"
+
+// Result holds the services, groups and imports a Generator found in a
+// given directory, plus any diagnostics (such as a malformed manifest it
+// skipped) it wants surfaced to the user.
+type Result struct {
+	Services    []*services.ServiceConfig
+	Groups      []*services.ServiceGroupConfig
+	Imports     []string
+	Diagnostics []Diagnostic
+}
+
+// Diagnostic is a warning or error a Generator wants surfaced alongside its
+// results, optionally pointing at the file and line it came from.
+type Diagnostic struct {
+	Level   string // "warning" or "error"
+	Message string
+	File    string
+	Line    int
+}
+
+// Generator inspects a project directory and proposes Edward config
+// content for it. The built-in docker-compose.yml generator implements this
+// interface, as do external generator plugins.
+type Generator interface {
+	// Name identifies the generator in diagnostics and conflict errors.
+	Name() string
+	// Generate inspects path and returns anything it found there. A
+	// generator that finds nothing returns a zero-value Result, not an
+	// error.
+	Generate(path string) (*Result, error)
+}
+
+var builtins []Generator
+
+// Register adds a generator to the set returned by Generators. It is
+// intended to be called from the init function of the file implementing
+// the generator.
+func Register(g Generator) {
+	builtins = append(builtins, g)
+}
+
+// Generators returns the built-in generators known to Edward. If
+// includePlugins is true, it also scans PATH for generator plugins and
+// appends those. Callers that don't want PATH scanned - such as tests that
+// don't control their process's PATH - should pass false.
+func Generators(includePlugins bool) []Generator {
+	out := make([]Generator, len(builtins))
+	copy(out, builtins)
+	if includePlugins {
+		out = append(out, DiscoverPlugins()...)
+	}
+	return out
+}
@@ -0,0 +1,151 @@
+package generators
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/yext/edward/services"
+)
+
+// pluginPrefix is the executable name prefix Edward looks for on PATH when
+// discovering generator plugins: a plugin for "lang" is named
+// "edward-generate-lang".
+const pluginPrefix = "edward-generate-"
+
+// GenerateContext is implemented by generators that want to know about the
+// surrounding Generate call beyond just the path being scanned.
+// PluginGenerator uses it to fill in the subprocess request; built-in
+// generators that don't need this information don't have to implement it.
+type GenerateContext interface {
+	SetContext(existingServices []string, targets []string)
+}
+
+type pluginRequest struct {
+	Root             string   `json:"root"`
+	Targets          []string `json:"targets"`
+	ExistingServices []string `json:"existing_services"`
+}
+
+type pluginResponse struct {
+	Services    []*services.ServiceConfig      `json:"services"`
+	Groups      []*services.ServiceGroupConfig `json:"groups"`
+	Imports     []string                       `json:"imports"`
+	Diagnostics []Diagnostic                   `json:"diagnostics"`
+}
+
+// PluginGenerator runs an external generator plugin found on PATH using
+// Edward's generator-plugin subprocess protocol: a JSON request is written
+// to the plugin's stdin, and a JSON response is read from its stdout. Any
+// non-JSON stderr output is surfaced as a warning diagnostic rather than
+// failing the generate.
+type PluginGenerator struct {
+	// Lang is the plugin's identifier, taken from the part of its
+	// executable name after "edward-generate-".
+	Lang string
+	// Executable is the absolute path to the plugin binary.
+	Executable string
+
+	existingServices []string
+	targets          []string
+}
+
+// Name identifies this plugin in diagnostics and conflict errors.
+func (p *PluginGenerator) Name() string {
+	return "plugin:" + p.Lang
+}
+
+// SetContext records the existing service names and requested targets so
+// Generate can forward them to the plugin.
+func (p *PluginGenerator) SetContext(existingServices []string, targets []string) {
+	p.existingServices = existingServices
+	p.targets = targets
+}
+
+// Generate invokes the plugin executable, sending it path as the scan root
+// and returning whatever services, groups, imports and diagnostics it
+// reports.
+func (p *PluginGenerator) Generate(path string) (*Result, error) {
+	reqBytes, err := json.Marshal(pluginRequest{
+		Root:             path,
+		Targets:          p.targets,
+		ExistingServices: p.existingServices,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(p.Executable)
+	cmd.Stdin = bytes.NewReader(reqBytes)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	runErr := cmd.Run()
+
+	var resp pluginResponse
+	if jsonErr := json.Unmarshal(stdout.Bytes(), &resp); jsonErr != nil {
+		if runErr != nil {
+			return nil, fmt.Errorf("%s: %v: %s", p.Executable, runErr, strings.TrimSpace(stderr.String()))
+		}
+		return nil, fmt.Errorf("%s: invalid response: %v", p.Executable, jsonErr)
+	}
+
+	if stderr.Len() > 0 {
+		resp.Diagnostics = append(resp.Diagnostics, Diagnostic{
+			Level:   "warning",
+			Message: strings.TrimSpace(stderr.String()),
+		})
+	}
+
+	return &Result{
+		Services:    resp.Services,
+		Groups:      resp.Groups,
+		Imports:     resp.Imports,
+		Diagnostics: resp.Diagnostics,
+	}, nil
+}
+
+// DiscoverPlugins scans PATH for executables named "edward-generate-<lang>"
+// and returns a PluginGenerator for each one found, preferring the first
+// match for a given lang in case PATH lists it more than once.
+func DiscoverPlugins() []Generator {
+	var plugins []Generator
+	seen := make(map[string]bool)
+
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasPrefix(entry.Name(), pluginPrefix) {
+				continue
+			}
+			lang := strings.TrimPrefix(entry.Name(), pluginPrefix)
+			if runtime.GOOS == "windows" {
+				if !strings.HasSuffix(lang, ".exe") {
+					continue
+				}
+				lang = strings.TrimSuffix(lang, ".exe")
+			}
+			if seen[lang] {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil || (runtime.GOOS != "windows" && info.Mode()&0111 == 0) {
+				continue
+			}
+			seen[lang] = true
+			plugins = append(plugins, &PluginGenerator{
+				Lang:       lang,
+				Executable: filepath.Join(dir, entry.Name()),
+			})
+		}
+	}
+	return plugins
+}
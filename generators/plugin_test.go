@@ -0,0 +1,57 @@
+package generators
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// buildFixturePlugin compiles the fixture generator plugin in testdata and
+// returns the directory containing the resulting edward-generate-fixture
+// binary.
+func buildFixturePlugin(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	name := "edward-generate-fixture"
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	out := filepath.Join(dir, name)
+
+	cmd := exec.Command("go", "build", "-o", out, "./testdata/plugins/fixture")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("could not build fixture plugin: %v\n%s", err, output)
+	}
+	return dir
+}
+
+func TestDiscoverPlugins(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	dir := buildFixturePlugin(t)
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	plugins := DiscoverPlugins()
+	if len(plugins) != 1 {
+		t.Fatalf("expected 1 plugin, got %d", len(plugins))
+	}
+	if plugins[0].Name() != "plugin:fixture" {
+		t.Errorf("expected plugin named plugin:fixture, got %s", plugins[0].Name())
+	}
+
+	result, err := plugins[0].Generate("/some/project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Services) != 1 || result.Services[0].Name != "fixture-service" {
+		t.Fatalf("expected a single fixture-service, got %+v", result.Services)
+	}
+	if len(result.Diagnostics) != 1 || result.Diagnostics[0].Level != "warning" {
+		t.Fatalf("expected one warning diagnostic from stderr, got %+v", result.Diagnostics)
+	}
+}
@@ -0,0 +1,40 @@
+// Command fixture is a minimal generator plugin used by
+// TestDiscoverPlugins to validate the subprocess protocol: it returns a
+// single service named "fixture-service", whatever the request, and writes
+// a line naming the request's root to stderr so the warning-surfacing path
+// gets exercised too.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+type request struct {
+	Root             string   `json:"root"`
+	Targets          []string `json:"targets"`
+	ExistingServices []string `json:"existing_services"`
+}
+
+type service struct {
+	Name string `json:"name"`
+}
+
+type response struct {
+	Services []service `json:"services"`
+}
+
+func main() {
+	var req request
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		fmt.Fprintln(os.Stderr, "fixture: could not decode request:", err)
+		os.Exit(1)
+	}
+
+	fmt.Fprintln(os.Stderr, "fixture: scanned "+req.Root)
+
+	json.NewEncoder(os.Stdout).Encode(response{
+		Services: []service{{Name: "fixture-service"}},
+	})
+}
@@ -0,0 +1,49 @@
+// Package home manages the per-user directory where Edward stores state:
+// logs, pidfiles and generated scripts.
+package home
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// EdwardConfig is the global handle on Edward's home directory. Callers must
+// invoke Initialize before relying on its paths.
+var EdwardConfig = &Config{}
+
+// Config describes the layout of Edward's home directory.
+type Config struct {
+	Dir string
+}
+
+// Initialize ensures the Edward home directory (and its subdirectories)
+// exist, creating them if necessary.
+func (c *Config) Initialize() error {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+	c.Dir = filepath.Join(home, ".edward")
+
+	for _, sub := range []string{"log", "pidFiles", "scripts"} {
+		if err := os.MkdirAll(filepath.Join(c.Dir, sub), 0755); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Logs returns the directory Edward uses for service log files.
+func (c *Config) Logs() string {
+	return filepath.Join(c.Dir, "log")
+}
+
+// PidFiles returns the directory Edward uses for service pid files.
+func (c *Config) PidFiles() string {
+	return filepath.Join(c.Dir, "pidFiles")
+}
+
+// Scripts returns the directory Edward uses for generated wrapper scripts.
+func (c *Config) Scripts() string {
+	return filepath.Join(c.Dir, "scripts")
+}
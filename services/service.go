@@ -0,0 +1,42 @@
+// Package services defines the configuration types for a single Edward
+// service and for groups of services, independent of how that
+// configuration was loaded or generated.
+package services
+
+// Commands holds the shell commands Edward runs at each stage of a
+// service's lifecycle. Any of these may be empty if the stage does not
+// apply to a given service.
+type Commands struct {
+	Build  string `json:"build,omitempty"`
+	Launch string `json:"launch,omitempty"`
+	Stop   string `json:"stop,omitempty"`
+}
+
+// LaunchChecks describes how Edward detects that a service has finished
+// starting up.
+type LaunchChecks struct {
+	// Ports lists TCP ports Edward polls; the service is considered started
+	// once all of them are open.
+	Ports []int `json:"ports,omitempty"`
+	// LogText, if set, is a string Edward waits to see in the service's
+	// logs before considering it started.
+	LogText string `json:"log_text,omitempty"`
+}
+
+// ServiceConfig is the configuration for a single service managed by
+// Edward.
+type ServiceConfig struct {
+	Name         string            `json:"name"`
+	Path         *string           `json:"path,omitempty"`
+	Env          []string          `json:"env,omitempty"`
+	Commands     Commands          `json:"commands"`
+	LaunchChecks LaunchChecks      `json:"launch_checks,omitempty"`
+	Properties   map[string]string `json:"properties,omitempty"`
+}
+
+// ServiceGroupConfig is a named collection of services and/or other groups
+// that can be built, launched and stopped together.
+type ServiceGroupConfig struct {
+	Name     string   `json:"name"`
+	Children []string `json:"children,omitempty"`
+}